@@ -0,0 +1,48 @@
+// Command prisma-gen reads a schema.prisma file and writes the model
+// structs, Where/OrderBy/FindMany types, query-execution glue, From*
+// navigation types, pagination iterators, and LoadMore methods for
+// every model and relation to a single generated Go file in the
+// prisma package. It assumes the package's DB/Request/Response
+// interface, More type and String/Strings/Int/Ints helpers are
+// already present.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "prisma/schema.prisma", "path to the schema.prisma file")
+	outPath := flag.String("out", "prisma/zz_generated.go", "path to write the generated Go file")
+	flag.Parse()
+
+	if err := run(*schemaPath, *outPath); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath, outPath string) error {
+	f, err := os.Open(schemaPath)
+	if err != nil {
+		return fmt.Errorf("prisma-gen: opening schema: %w", err)
+	}
+	defer f.Close()
+
+	models, err := ParseSchema(f)
+	if err != nil {
+		return err
+	}
+
+	src, err := Generate(models)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(outPath, []byte(src), 0o644); err != nil {
+		return fmt.Errorf("prisma-gen: writing output: %w", err)
+	}
+	return nil
+}
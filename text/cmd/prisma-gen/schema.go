@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Field is one line inside a schema.prisma model block.
+type Field struct {
+	// Name is the schema field name, e.g. "firstName".
+	Name string
+	// Type is the schema scalar type (String, Int, Float, Boolean, DateTime)
+	// or, for a relation, the related model's name.
+	Type string
+	// List is true for "Type[]" fields.
+	List bool
+	// Relation is true when Type names another model rather than a
+	// scalar.
+	Relation bool
+}
+
+// Model is one "model Name { ... }" block in a schema.prisma file.
+type Model struct {
+	Name   string
+	Fields []Field
+}
+
+var modelHeader = regexp.MustCompile(`^model\s+(\w+)\s*\{\s*$`)
+var fieldLine = regexp.MustCompile(`^(\w+)\s+(\w+)(\[\])?`)
+
+var scalarTypes = map[string]bool{
+	"String":   true,
+	"Int":      true,
+	"Float":    true,
+	"Boolean":  true,
+	"DateTime": true,
+}
+
+// ParseSchema reads a schema.prisma file and returns its models in the
+// order they're declared. Field types that don't name a known scalar
+// are resolved against other models in the file and marked Relation.
+func ParseSchema(r io.Reader) ([]*Model, error) {
+	var models []*Model
+	cur := (*Model)(nil)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if cur == nil {
+			if m := modelHeader.FindStringSubmatch(line); m != nil {
+				cur = &Model{Name: m[1]}
+			}
+			continue
+		}
+		if line == "}" {
+			models = append(models, cur)
+			cur = nil
+			continue
+		}
+		m := fieldLine.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("prisma-gen: invalid field %q in model %s", line, cur.Name)
+		}
+		cur.Fields = append(cur.Fields, Field{
+			Name: m[1],
+			Type: m[2],
+			List: m[3] == "[]",
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("prisma-gen: unterminated model %s", cur.Name)
+	}
+
+	names := map[string]bool{}
+	for _, model := range models {
+		names[model.Name] = true
+	}
+	for _, model := range models {
+		for i, field := range model.Fields {
+			if !scalarTypes[field.Type] && names[field.Type] {
+				model.Fields[i].Relation = true
+			}
+		}
+	}
+	return models, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSchema(t *testing.T) {
+	src := `model User {
+  id    String
+  email String
+  posts Post[]
+}
+
+model Post {
+  id    String
+  title String
+}
+`
+	models, err := ParseSchema(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(models) != 2 {
+		t.Fatalf("expected 2 models, got %d", len(models))
+	}
+	if models[0].Name != "User" {
+		t.Fatalf("expected first model User, got %s", models[0].Name)
+	}
+	posts := models[0].Fields[2]
+	if posts.Name != "posts" || posts.Type != "Post" || !posts.List || !posts.Relation {
+		t.Fatalf("expected posts to be a Post[] relation, got %+v", posts)
+	}
+}
+
+func TestParseSchemaInvalidField(t *testing.T) {
+	src := `model User {
+  orphan
+}
+`
+	if _, err := ParseSchema(strings.NewReader(src)); err == nil {
+		t.Fatal("expected an error for an invalid field line")
+	}
+}
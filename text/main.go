@@ -1,17 +1,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/matthewmueller/_hack/prisma/prisma"
 )
 
 func main() {
-	var db prisma.DB
+	ctx := context.Background()
+	db := prisma.NewGraphQLDB("https://example.prisma.io/graphql")
 
 	// find many users based on nested resources
 	emailAsc := prisma.UsersEmailASC
-	users, err := prisma.Users.FindMany(db, &prisma.UsersFindMany{
+	users, err := prisma.Users.FindMany(ctx, db, &prisma.UsersFindMany{
 		After:   prisma.String(""),
 		Before:  prisma.String(""),
 		First:   prisma.Int(1),
@@ -47,7 +49,7 @@ func main() {
 				},
 			},
 		}).Comments.
-		FindMany(db, &prisma.CommentsFindMany{
+		FindMany(ctx, db, &prisma.CommentsFindMany{
 			Where: &prisma.CommentsWhere{
 				Comment: prisma.String("my comment"),
 			},
@@ -57,4 +59,19 @@ func main() {
 	}
 
 	fmt.Println(comments)
+
+	// a similar users query (email and post title filters, email order,
+	// first 10), built with the functional-options builder
+	built, err := prisma.Users.Query().
+		Where(
+			prisma.UserEmail.Eq("alice@prisma.io"),
+			prisma.UserPosts.Some(prisma.PostTitle.Contains("my title")),
+		).
+		OrderBy(prisma.UserEmail.Asc()).
+		First(10).
+		FindMany(ctx, db)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(built)
 }
@@ -0,0 +1,357 @@
+package prisma
+
+import "context"
+
+// UsersWhereOption sets one field on a UsersWhere. Composing several
+// builds up a single UsersWhere, mirroring the repository-option
+// pattern used elsewhere in the Go ecosystem.
+type UsersWhereOption func(*UsersWhere)
+
+func buildUsersWhere(opts []UsersWhereOption) *UsersWhere {
+	if len(opts) == 0 {
+		return nil
+	}
+	w := &UsersWhere{}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+type usersIDField struct{}
+
+// UserID selects the User.ID field for Where building.
+var UserID = usersIDField{}
+
+func (usersIDField) Eq(v string) UsersWhereOption { return func(w *UsersWhere) { w.ID = String(v) } }
+func (usersIDField) Contains(v string) UsersWhereOption {
+	return func(w *UsersWhere) { w.IDContains = String(v) }
+}
+func (usersIDField) In(vs ...string) UsersWhereOption {
+	return func(w *UsersWhere) { w.IDIn = Strings(vs...) }
+}
+
+type usersEmailField struct{}
+
+// UserEmail selects the User.Email field for Where/OrderBy building.
+var UserEmail = usersEmailField{}
+
+func (usersEmailField) Eq(v string) UsersWhereOption {
+	return func(w *UsersWhere) { w.Email = String(v) }
+}
+func (usersEmailField) Contains(v string) UsersWhereOption {
+	return func(w *UsersWhere) { w.EmailContains = String(v) }
+}
+func (usersEmailField) In(vs ...string) UsersWhereOption {
+	return func(w *UsersWhere) { w.EmailIn = Strings(vs...) }
+}
+func (usersEmailField) Asc() UsersOrderBy  { return UsersEmailASC }
+func (usersEmailField) Desc() UsersOrderBy { return UsersEmailDESC }
+
+type usersFirstNameField struct{}
+
+// UserFirstName selects the User.FirstName field for Where building.
+var UserFirstName = usersFirstNameField{}
+
+func (usersFirstNameField) Eq(v string) UsersWhereOption {
+	return func(w *UsersWhere) { w.FirstName = String(v) }
+}
+func (usersFirstNameField) Contains(v string) UsersWhereOption {
+	return func(w *UsersWhere) { w.FirstNameContains = String(v) }
+}
+
+type usersLastNameField struct{}
+
+// UserLastName selects the User.LastName field for Where building.
+var UserLastName = usersLastNameField{}
+
+func (usersLastNameField) Eq(v string) UsersWhereOption {
+	return func(w *UsersWhere) { w.LastName = String(v) }
+}
+func (usersLastNameField) Contains(v string) UsersWhereOption {
+	return func(w *UsersWhere) { w.LastNameContains = String(v) }
+}
+
+type usersPostsField struct{}
+
+// UserPosts selects the User.Posts relation for Where building.
+var UserPosts = usersPostsField{}
+
+func (usersPostsField) Some(opts ...PostsWhereOption) UsersWhereOption {
+	return func(w *UsersWhere) { w.PostsSome = buildPostsWhere(opts) }
+}
+func (usersPostsField) Every(opts ...PostsWhereOption) UsersWhereOption {
+	return func(w *UsersWhere) { w.PostsEvery = buildPostsWhere(opts) }
+}
+func (usersPostsField) None(opts ...PostsWhereOption) UsersWhereOption {
+	return func(w *UsersWhere) { w.PostsNone = buildPostsWhere(opts) }
+}
+
+// UsersQuery is a chainable builder that compiles to the same
+// UsersFindMany condition as the struct-literal API.
+type UsersQuery struct {
+	condition UsersFindMany
+	whereOpts []UsersWhereOption
+}
+
+// Query starts a chainable UsersQuery.
+func (u *users) Query() *UsersQuery { return &UsersQuery{} }
+
+// Where adds filtering options, composing with any already set.
+func (q *UsersQuery) Where(opts ...UsersWhereOption) *UsersQuery {
+	q.whereOpts = append(q.whereOpts, opts...)
+	return q
+}
+
+// OrderBy sets the sort order.
+func (q *UsersQuery) OrderBy(o UsersOrderBy) *UsersQuery {
+	q.condition.OrderBy = &o
+	return q
+}
+
+// First sets the page size from the start of the result set.
+func (q *UsersQuery) First(n int) *UsersQuery { q.condition.First = Int(n); return q }
+
+// Last sets the page size from the end of the result set.
+func (q *UsersQuery) Last(n int) *UsersQuery { q.condition.Last = Int(n); return q }
+
+// Skip sets the number of results to skip.
+func (q *UsersQuery) Skip(n int) *UsersQuery { q.condition.Skip = Int(n); return q }
+
+// After sets the cursor to page forward from.
+func (q *UsersQuery) After(cursor string) *UsersQuery { q.condition.After = String(cursor); return q }
+
+// Before sets the cursor to page backward from.
+func (q *UsersQuery) Before(cursor string) *UsersQuery { q.condition.Before = String(cursor); return q }
+
+// FindMany compiles the query to a UsersFindMany condition and runs it.
+func (q *UsersQuery) FindMany(ctx context.Context, db DB) ([]*User, error) {
+	condition := q.condition
+	condition.Where = buildUsersWhere(q.whereOpts)
+	return Users.FindMany(ctx, db, &condition)
+}
+
+// Iterate compiles the query to a UsersFindMany condition and returns
+// an iterator over it.
+func (q *UsersQuery) Iterate(db DB) *UsersIterator {
+	condition := q.condition
+	condition.Where = buildUsersWhere(q.whereOpts)
+	return Users.Iterate(db, &condition)
+}
+
+// PostsWhereOption sets one field on a PostsWhere.
+type PostsWhereOption func(*PostsWhere)
+
+func buildPostsWhere(opts []PostsWhereOption) *PostsWhere {
+	if len(opts) == 0 {
+		return nil
+	}
+	w := &PostsWhere{}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+type postsIDField struct{}
+
+// PostID selects the Post.ID field for Where building.
+var PostID = postsIDField{}
+
+func (postsIDField) Eq(v string) PostsWhereOption { return func(w *PostsWhere) { w.ID = String(v) } }
+func (postsIDField) Contains(v string) PostsWhereOption {
+	return func(w *PostsWhere) { w.IDContains = String(v) }
+}
+
+type postsTitleField struct{}
+
+// PostTitle selects the Post.Title field for Where/OrderBy building.
+var PostTitle = postsTitleField{}
+
+func (postsTitleField) Eq(v string) PostsWhereOption {
+	return func(w *PostsWhere) { w.Title = String(v) }
+}
+func (postsTitleField) Contains(v string) PostsWhereOption {
+	return func(w *PostsWhere) { w.TitleContains = String(v) }
+}
+func (postsTitleField) Asc() PostsOrderBy  { return PostsTitleASC }
+func (postsTitleField) Desc() PostsOrderBy { return PostsTitleDESC }
+
+type postsBodyField struct{}
+
+// PostBody selects the Post.Body field for Where building.
+var PostBody = postsBodyField{}
+
+func (postsBodyField) Eq(v string) PostsWhereOption {
+	return func(w *PostsWhere) { w.Body = String(v) }
+}
+func (postsBodyField) Contains(v string) PostsWhereOption {
+	return func(w *PostsWhere) { w.BodyContains = String(v) }
+}
+
+type postsCommentsField struct{}
+
+// PostComments selects the Post.Comments relation for Where building.
+var PostComments = postsCommentsField{}
+
+func (postsCommentsField) Some(opts ...CommentsWhereOption) PostsWhereOption {
+	return func(w *PostsWhere) { w.CommentsSome = buildCommentsWhere(opts) }
+}
+func (postsCommentsField) Every(opts ...CommentsWhereOption) PostsWhereOption {
+	return func(w *PostsWhere) { w.CommentsEvery = buildCommentsWhere(opts) }
+}
+func (postsCommentsField) None(opts ...CommentsWhereOption) PostsWhereOption {
+	return func(w *PostsWhere) { w.CommentsNone = buildCommentsWhere(opts) }
+}
+
+// PostsQuery is a chainable builder that compiles to the same
+// PostsFindMany condition as the struct-literal API.
+type PostsQuery struct {
+	condition PostsFindMany
+	whereOpts []PostsWhereOption
+}
+
+// Query starts a chainable PostsQuery.
+func (p *posts) Query() *PostsQuery { return &PostsQuery{} }
+
+// Where adds filtering options, composing with any already set.
+func (q *PostsQuery) Where(opts ...PostsWhereOption) *PostsQuery {
+	q.whereOpts = append(q.whereOpts, opts...)
+	return q
+}
+
+// OrderBy sets the sort order.
+func (q *PostsQuery) OrderBy(o PostsOrderBy) *PostsQuery {
+	q.condition.OrderBy = &o
+	return q
+}
+
+// First sets the page size from the start of the result set.
+func (q *PostsQuery) First(n int) *PostsQuery { q.condition.First = Int(n); return q }
+
+// Last sets the page size from the end of the result set.
+func (q *PostsQuery) Last(n int) *PostsQuery { q.condition.Last = Int(n); return q }
+
+// Skip sets the number of results to skip.
+func (q *PostsQuery) Skip(n int) *PostsQuery { q.condition.Skip = Int(n); return q }
+
+// After sets the cursor to page forward from.
+func (q *PostsQuery) After(cursor string) *PostsQuery { q.condition.After = String(cursor); return q }
+
+// Before sets the cursor to page backward from.
+func (q *PostsQuery) Before(cursor string) *PostsQuery { q.condition.Before = String(cursor); return q }
+
+// FindMany compiles the query to a PostsFindMany condition and runs it.
+func (q *PostsQuery) FindMany(ctx context.Context, db DB) ([]*Post, error) {
+	condition := q.condition
+	condition.Where = buildPostsWhere(q.whereOpts)
+	return Posts.FindMany(ctx, db, &condition)
+}
+
+// Iterate compiles the query to a PostsFindMany condition and returns
+// an iterator over it.
+func (q *PostsQuery) Iterate(db DB) *PostsIterator {
+	condition := q.condition
+	condition.Where = buildPostsWhere(q.whereOpts)
+	return Posts.Iterate(db, &condition)
+}
+
+// CommentsWhereOption sets one field on a CommentsWhere.
+type CommentsWhereOption func(*CommentsWhere)
+
+func buildCommentsWhere(opts []CommentsWhereOption) *CommentsWhere {
+	if len(opts) == 0 {
+		return nil
+	}
+	w := &CommentsWhere{}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+type commentsIDField struct{}
+
+// CommentID selects the Comment.ID field for Where building.
+var CommentID = commentsIDField{}
+
+func (commentsIDField) Eq(v string) CommentsWhereOption {
+	return func(w *CommentsWhere) { w.ID = String(v) }
+}
+func (commentsIDField) Contains(v string) CommentsWhereOption {
+	return func(w *CommentsWhere) { w.IDContains = String(v) }
+}
+
+type commentsCommentField struct{}
+
+// CommentComment selects the Comment.Comment field for Where/OrderBy
+// building.
+var CommentComment = commentsCommentField{}
+
+func (commentsCommentField) Eq(v string) CommentsWhereOption {
+	return func(w *CommentsWhere) { w.Comment = String(v) }
+}
+func (commentsCommentField) Contains(v string) CommentsWhereOption {
+	return func(w *CommentsWhere) { w.CommentContains = String(v) }
+}
+func (commentsCommentField) Asc() CommentsOrderBy  { return CommentsCommentASC }
+func (commentsCommentField) Desc() CommentsOrderBy { return CommentsCommentDESC }
+
+// CommentsQuery is a chainable builder that compiles to the same
+// CommentsFindMany condition as the struct-literal API.
+type CommentsQuery struct {
+	condition CommentsFindMany
+	whereOpts []CommentsWhereOption
+}
+
+// Query starts a chainable CommentsQuery.
+func (c *comments) Query() *CommentsQuery { return &CommentsQuery{} }
+
+// Where adds filtering options, composing with any already set.
+func (q *CommentsQuery) Where(opts ...CommentsWhereOption) *CommentsQuery {
+	q.whereOpts = append(q.whereOpts, opts...)
+	return q
+}
+
+// OrderBy sets the sort order.
+func (q *CommentsQuery) OrderBy(o CommentsOrderBy) *CommentsQuery {
+	q.condition.OrderBy = &o
+	return q
+}
+
+// First sets the page size from the start of the result set.
+func (q *CommentsQuery) First(n int) *CommentsQuery { q.condition.First = Int(n); return q }
+
+// Last sets the page size from the end of the result set.
+func (q *CommentsQuery) Last(n int) *CommentsQuery { q.condition.Last = Int(n); return q }
+
+// Skip sets the number of results to skip.
+func (q *CommentsQuery) Skip(n int) *CommentsQuery { q.condition.Skip = Int(n); return q }
+
+// After sets the cursor to page forward from.
+func (q *CommentsQuery) After(cursor string) *CommentsQuery {
+	q.condition.After = String(cursor)
+	return q
+}
+
+// Before sets the cursor to page backward from.
+func (q *CommentsQuery) Before(cursor string) *CommentsQuery {
+	q.condition.Before = String(cursor)
+	return q
+}
+
+// FindMany compiles the query to a CommentsFindMany condition and runs
+// it.
+func (q *CommentsQuery) FindMany(ctx context.Context, db DB) ([]*Comment, error) {
+	condition := q.condition
+	condition.Where = buildCommentsWhere(q.whereOpts)
+	return Comments.FindMany(ctx, db, &condition)
+}
+
+// Iterate compiles the query to a CommentsFindMany condition and
+// returns an iterator over it.
+func (q *CommentsQuery) Iterate(db DB) *CommentsIterator {
+	condition := q.condition
+	condition.Where = buildCommentsWhere(q.whereOpts)
+	return Comments.Iterate(db, &condition)
+}
@@ -0,0 +1,73 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+// capturingDB records the last Request it was asked to run and
+// returns a fixed, empty result, so tests can assert on what a query
+// builder compiled without needing a real backend.
+type capturingDB struct {
+	last *Request
+}
+
+func (c *capturingDB) Query(ctx context.Context, req *Request) (*Response, error) {
+	c.last = req
+	return &Response{Data: json.RawMessage(`{"users":[]}`)}, nil
+}
+
+func (c *capturingDB) Exec(ctx context.Context, req *Request) (*Response, error) {
+	return c.Query(ctx, req)
+}
+
+func TestUsersQueryCompilesWhereAndOrderBy(t *testing.T) {
+	db := &capturingDB{}
+
+	_, err := Users.Query().
+		Where(
+			UserEmail.Eq("alice@prisma.io"),
+			UserPosts.Some(PostTitle.Contains("my title")),
+		).
+		OrderBy(UserEmail.Asc()).
+		First(10).
+		FindMany(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	where, ok := db.last.Variables["where"].(*UsersWhere)
+	if !ok || where == nil {
+		t.Fatalf("expected a *UsersWhere, got %v", db.last.Variables["where"])
+	}
+	if where.Email == nil || *where.Email != "alice@prisma.io" {
+		t.Fatalf("expected where.Email=alice@prisma.io, got %v", where.Email)
+	}
+	if where.PostsSome == nil || where.PostsSome.TitleContains == nil || *where.PostsSome.TitleContains != "my title" {
+		t.Fatalf("expected where.PostsSome.TitleContains=my title, got %v", where.PostsSome)
+	}
+
+	orderBy, ok := db.last.Variables["orderBy"].(*UsersOrderBy)
+	if !ok || orderBy == nil || *orderBy != UsersEmailASC {
+		t.Fatalf("expected orderBy=UsersEmailASC, got %v", db.last.Variables["orderBy"])
+	}
+
+	first, ok := db.last.Variables["first"].(*int)
+	if !ok || first == nil || *first != 10 {
+		t.Fatalf("expected first=10, got %v", db.last.Variables["first"])
+	}
+}
+
+func TestUsersQueryWithNoWhereLeavesConditionEmpty(t *testing.T) {
+	db := &capturingDB{}
+
+	_, err := Users.Query().FindMany(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if db.last.Variables["where"] != (*UsersWhere)(nil) {
+		t.Fatalf("expected a nil *UsersWhere, got %v", db.last.Variables["where"])
+	}
+}
@@ -0,0 +1,26 @@
+package prisma
+
+import "context"
+
+// Request is a single GraphQL operation ready to be sent to a DB. Drivers
+// only need to know how to round-trip a Request into a Response; all the
+// query-shaping happens in this package.
+type Request struct {
+	Query     string
+	Variables map[string]interface{}
+}
+
+// Response is the raw result of a Request. Callers unmarshal Data into
+// the model-specific shape once the round-trip succeeds.
+type Response struct {
+	Data []byte
+}
+
+// DB is the interface every backend must implement to execute the
+// queries and mutations generated by this package. Query runs read
+// operations (FindMany, ...); Exec runs mutations, though nothing in
+// this package issues one.
+type DB interface {
+	Query(ctx context.Context, req *Request) (*Response, error)
+	Exec(ctx context.Context, req *Request) (*Response, error)
+}
@@ -0,0 +1,76 @@
+package prisma
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// GraphQLDB is a DB backed by a Prisma GraphQL HTTP endpoint.
+type GraphQLDB struct {
+	Endpoint string
+	Client   *http.Client
+}
+
+var _ DB = (*GraphQLDB)(nil)
+
+// NewGraphQLDB creates a GraphQLDB pointed at endpoint, using
+// http.DefaultClient.
+func NewGraphQLDB(endpoint string) *GraphQLDB {
+	return &GraphQLDB{Endpoint: endpoint, Client: http.DefaultClient}
+}
+
+// Query sends req as a GraphQL query.
+func (g *GraphQLDB) Query(ctx context.Context, req *Request) (*Response, error) {
+	return g.do(ctx, req)
+}
+
+// Exec sends req as a GraphQL mutation.
+func (g *GraphQLDB) Exec(ctx context.Context, req *Request) (*Response, error) {
+	return g.do(ctx, req)
+}
+
+func (g *GraphQLDB) do(ctx context.Context, req *Request) (*Response, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     req.Query,
+		"variables": req.Variables,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prisma: marshaling request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, g.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("prisma: building request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	client := g.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("prisma: executing request: %w", err)
+	}
+	defer res.Body.Close()
+
+	var out struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphQLError  `json:"errors"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("prisma: decoding response: %w", err)
+	}
+	if len(out.Errors) > 0 {
+		return nil, errors.New("prisma: " + out.Errors[0].Message)
+	}
+	return &Response{Data: out.Data}, nil
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
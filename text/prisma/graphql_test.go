@@ -0,0 +1,72 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGraphQLDBQuerySendsQueryAndVariables(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"users":[{"id":"1"}]}}`))
+	}))
+	defer srv.Close()
+
+	db := NewGraphQLDB(srv.URL)
+	email := "alice@prisma.io"
+	req := usersFindManyRequest(&UsersFindMany{
+		First: Int(10),
+		Where: &UsersWhere{Email: &email},
+	})
+
+	res, err := db.Query(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body["query"] != req.Query {
+		t.Fatalf("expected query to be forwarded verbatim, got %v", body["query"])
+	}
+	variables, ok := body["variables"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected variables to be an object, got %T", body["variables"])
+	}
+	if variables["first"] != float64(10) {
+		t.Fatalf("expected first=10, got %v", variables["first"])
+	}
+	where, ok := variables["where"].(map[string]interface{})
+	if !ok || where["email"] != email {
+		t.Fatalf("expected where.email=%q, got %v", email, variables["where"])
+	}
+
+	var out struct {
+		Users []*User `json:"users"`
+	}
+	if err := json.Unmarshal(res.Data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Users) != 1 || out.Users[0].ID != "1" {
+		t.Fatalf("expected one user with id 1, got %v", out.Users)
+	}
+}
+
+func TestGraphQLDBQueryReturnsErrorOnGraphQLErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"errors":[{"message":"boom"}]}`))
+	}))
+	defer srv.Close()
+
+	db := NewGraphQLDB(srv.URL)
+	_, err := db.Query(context.Background(), usersFindManyRequest(nil))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
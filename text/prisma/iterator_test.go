@@ -0,0 +1,106 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+// pagedUsersDB serves fixed-size pages from a total pool of users,
+// like a real cursor-paginated backend would.
+type pagedUsersDB struct {
+	all []*User
+}
+
+func (p *pagedUsersDB) Query(ctx context.Context, req *Request) (*Response, error) {
+	first, _ := req.Variables["first"].(*int)
+	after, _ := req.Variables["after"].(*string)
+
+	start := 0
+	if after != nil {
+		for i, u := range p.all {
+			if u.ID == *after {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end := len(p.all)
+	if first != nil && start+*first < end {
+		end = start + *first
+	}
+	if start > end {
+		start = end
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"users": p.all[start:end]})
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Data: data}, nil
+}
+
+func (p *pagedUsersDB) Exec(ctx context.Context, req *Request) (*Response, error) {
+	return p.Query(ctx, req)
+}
+
+func TestUsersIteratorPagesUntilExhausted(t *testing.T) {
+	db := &pagedUsersDB{all: []*User{
+		{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"},
+	}}
+
+	it := Users.Iterate(db, &UsersFindMany{First: Int(2)})
+
+	var got []*User
+	for {
+		user, ok, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			break
+		}
+		got = append(got, user)
+	}
+
+	if len(got) != 5 {
+		t.Fatalf("expected 5 users, got %d", len(got))
+	}
+	for i, user := range got {
+		if user.ID != db.all[i].ID {
+			t.Fatalf("expected user %d to be %s, got %s", i, db.all[i].ID, user.ID)
+		}
+	}
+
+	if _, ok, err := it.Next(context.Background()); ok || err != nil {
+		t.Fatalf("expected iterator to stay exhausted, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestUsersIteratorCollectRespectsMax(t *testing.T) {
+	db := &pagedUsersDB{all: []*User{
+		{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"},
+	}}
+
+	it := Users.Iterate(db, &UsersFindMany{First: Int(2)})
+	got, err := it.Collect(context.Background(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 users, got %d", len(got))
+	}
+}
+
+func TestUsersIteratorEmptyResult(t *testing.T) {
+	db := &pagedUsersDB{}
+	it := Users.Iterate(db, &UsersFindMany{First: Int(2)})
+
+	_, ok, err := it.Next(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected no results")
+	}
+}
@@ -0,0 +1,33 @@
+package prisma
+
+// More is a sentinel standing in for a relation list that was
+// truncated, e.g. a Comment's Replies with more children than a
+// caller wants to fetch in one response. It carries the parent's ID
+// and the IDs still to fetch, mirroring how tree-structured APIs (e.g.
+// Reddit's morechildren) defer large subtrees to a follow-up call.
+//
+// Nothing in this package constructs a More from a query response:
+// FindMany never selects nested relation sub-fields (see the Replies
+// field comment on Comment), so there is no truncation signal for it
+// to detect. Callers who know out-of-band that a subtree was cut off
+// — because they fetched it themselves, paginated it client-side, or
+// have some other source of the missing IDs — build a More by hand
+// and pass it to LoadMore/Splice to resolve it in a follow-up
+// round-trip.
+type More struct {
+	ParentID string
+	IDs      []string
+}
+
+// Splice appends children to parent.Replies, provided parent is the
+// one more.ParentID refers to, and returns the updated slice.
+func (more *More) Splice(parent *Comment, children []*Comment) []*Comment {
+	if parent == nil {
+		return nil
+	}
+	if parent.ID != more.ParentID {
+		return parent.Replies
+	}
+	parent.Replies = append(parent.Replies, children...)
+	return parent.Replies
+}
@@ -0,0 +1,74 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+type fakeCommentsDB struct {
+	comments []*Comment
+}
+
+func (f *fakeCommentsDB) Query(ctx context.Context, req *Request) (*Response, error) {
+	data, err := json.Marshal(map[string]interface{}{"comments": f.comments})
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Data: data}, nil
+}
+
+func (f *fakeCommentsDB) Exec(ctx context.Context, req *Request) (*Response, error) {
+	return f.Query(ctx, req)
+}
+
+func TestSpliceNilParentDoesNotPanic(t *testing.T) {
+	more := &More{ParentID: "1", IDs: []string{"2"}}
+	if got := more.Splice(nil, []*Comment{{ID: "2"}}); got != nil {
+		t.Fatalf("expected nil, got %v", got)
+	}
+}
+
+func TestSpliceMismatchedParentLeavesRepliesUntouched(t *testing.T) {
+	parent := &Comment{ID: "1", Replies: []*Comment{{ID: "existing"}}}
+	more := &More{ParentID: "other", IDs: []string{"2"}}
+	got := more.Splice(parent, []*Comment{{ID: "2"}})
+	if len(got) != 1 || got[0].ID != "existing" {
+		t.Fatalf("expected replies untouched, got %v", got)
+	}
+}
+
+func TestSpliceAppendsMatchingParent(t *testing.T) {
+	parent := &Comment{ID: "1"}
+	more := &More{ParentID: "1", IDs: []string{"2", "3"}}
+	got := more.Splice(parent, []*Comment{{ID: "2"}, {ID: "3"}})
+	if len(got) != 2 || parent.Replies[0].ID != "2" || parent.Replies[1].ID != "3" {
+		t.Fatalf("expected replies spliced in, got %v", got)
+	}
+}
+
+func TestLoadMoreNilSentinelReturnsNothing(t *testing.T) {
+	children, err := Comments.LoadMore(context.Background(), &fakeCommentsDB{}, nil)
+	if err != nil || children != nil {
+		t.Fatalf("expected (nil, nil), got (%v, %v)", children, err)
+	}
+}
+
+func TestLoadMoreFetchesAndSplices(t *testing.T) {
+	db := &fakeCommentsDB{comments: []*Comment{{ID: "2"}, {ID: "3"}}}
+	more := &More{ParentID: "1", IDs: []string{"2", "3"}}
+
+	children, err := Comments.LoadMore(context.Background(), db, more)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("expected 2 children, got %d", len(children))
+	}
+
+	parent := &Comment{ID: "1"}
+	replies := more.Splice(parent, children)
+	if len(replies) != 2 || parent.Replies[0].ID != "2" {
+		t.Fatalf("expected spliced replies, got %v", parent.Replies)
+	}
+}
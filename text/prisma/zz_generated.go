@@ -0,0 +1,566 @@
+// Code generated by prisma-gen. DO NOT EDIT.
+
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// defaultPageSize is the page size iterators request when the caller's
+// condition does not set First.
+const defaultPageSize = 100
+
+type users struct{}
+
+// Users implementation
+var Users = &users{}
+
+// User struct
+type User struct {
+	ID        string `json:"id,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	// Posts is the nested posts relation. FindMany never populates
+	// it; it stays nil until a caller assigns it.
+	Posts []*Post `json:"posts,omitempty"`
+}
+
+// UsersOrderBy type
+type UsersOrderBy string
+
+// OrderBy Enums
+const (
+	UsersIDASC         UsersOrderBy = "id_ASC"
+	UsersIDDESC        UsersOrderBy = "id_DESC"
+	UsersFirstNameASC  UsersOrderBy = "first_name_ASC"
+	UsersFirstNameDESC UsersOrderBy = "first_name_DESC"
+	UsersLastNameASC   UsersOrderBy = "last_name_ASC"
+	UsersLastNameDESC  UsersOrderBy = "last_name_DESC"
+	UsersEmailASC      UsersOrderBy = "email_ASC"
+	UsersEmailDESC     UsersOrderBy = "email_DESC"
+)
+
+// UsersWhere struct
+type UsersWhere struct {
+	ID                *string     `json:"id,omitempty"`
+	IDContains        *string     `json:"id_contains,omitempty"`
+	IDIn              *[]string   `json:"id_in,omitempty"`
+	FirstName         *string     `json:"first_name,omitempty"`
+	FirstNameContains *string     `json:"first_name_contains,omitempty"`
+	FirstNameIn       *[]string   `json:"first_name_in,omitempty"`
+	LastName          *string     `json:"last_name,omitempty"`
+	LastNameContains  *string     `json:"last_name_contains,omitempty"`
+	LastNameIn        *[]string   `json:"last_name_in,omitempty"`
+	Email             *string     `json:"email,omitempty"`
+	EmailContains     *string     `json:"email_contains,omitempty"`
+	EmailIn           *[]string   `json:"email_in,omitempty"`
+	PostsSome         *PostsWhere `json:"posts_some,omitempty"`
+	PostsEvery        *PostsWhere `json:"posts_every,omitempty"`
+	PostsNone         *PostsWhere `json:"posts_none,omitempty"`
+}
+
+// UsersFindMany struct
+type UsersFindMany struct {
+	After   *string
+	Before  *string
+	First   *int
+	Last    *int
+	Skip    *int
+	OrderBy *UsersOrderBy
+	Where   *UsersWhere
+}
+
+const usersFindManyQuery = `query UsersFindMany($where: UserWhereInput, $orderBy: UserOrderByInput, $first: Int, $last: Int, $before: String, $after: String, $skip: Int) {
+  users(where: $where, orderBy: $orderBy, first: $first, last: $last, before: $before, after: $after, skip: $skip) {
+    id
+    first_name
+    last_name
+    email
+  }
+}`
+
+func usersFindManyRequest(condition *UsersFindMany) *Request {
+	if condition == nil {
+		condition = &UsersFindMany{}
+	}
+	return &Request{
+		Query: usersFindManyQuery,
+		Variables: map[string]interface{}{
+			"where":   condition.Where,
+			"orderBy": condition.OrderBy,
+			"first":   condition.First,
+			"last":    condition.Last,
+			"before":  condition.Before,
+			"after":   condition.After,
+			"skip":    condition.Skip,
+		},
+	}
+}
+
+func (u *users) findMany(ctx context.Context, db DB, condition *UsersFindMany) ([]*User, error) {
+	res, err := db.Query(ctx, usersFindManyRequest(condition))
+	if err != nil {
+		return nil, fmt.Errorf("prisma: finding users: %w", err)
+	}
+	var out struct {
+		Users []*User `json:"users"`
+	}
+	if err := json.Unmarshal(res.Data, &out); err != nil {
+		return nil, fmt.Errorf("prisma: unmarshaling users: %w", err)
+	}
+	return out.Users, nil
+}
+
+// FindMany runs condition against db.
+func (u *users) FindMany(ctx context.Context, db DB, condition *UsersFindMany) (users []*User, err error) {
+	return u.findMany(ctx, db, condition)
+}
+
+func (u *users) FromMany(condition *UsersFindMany) *UsersFromMany {
+	return &UsersFromMany{condition, Posts}
+}
+
+// UsersFromMany struct
+type UsersFromMany struct {
+	condition *UsersFindMany
+	Posts     *posts
+}
+
+// UsersIterator pages through the results of a UsersFindMany query,
+// automatically feeding the last cursor back in as After until
+// exhausted.
+type UsersIterator struct {
+	db        DB
+	condition UsersFindMany
+	buf       []*User
+	pos       int
+	done      bool
+}
+
+// Iterate returns a UsersIterator over condition. The condition is copied,
+// so callers may reuse the original struct.
+func (u *users) Iterate(db DB, condition *UsersFindMany) *UsersIterator {
+	it := &UsersIterator{db: db}
+	if condition != nil {
+		it.condition = *condition
+	}
+	if it.condition.First == nil {
+		it.condition.First = Int(defaultPageSize)
+	}
+	return it
+}
+
+// Next returns the next User, fetching another page when the
+// current one is exhausted. The bool is false once there are no
+// more results.
+func (it *UsersIterator) Next(ctx context.Context) (*User, bool, error) {
+	if it.pos >= len(it.buf) {
+		if it.done {
+			return nil, false, nil
+		}
+		page, err := Users.findMany(ctx, it.db, &it.condition)
+		if err != nil {
+			return nil, false, err
+		}
+		it.buf = page
+		it.pos = 0
+		if len(page) == 0 || len(page) < *it.condition.First {
+			it.done = true
+		} else {
+			it.condition.After = String(page[len(page)-1].ID)
+		}
+		if len(it.buf) == 0 {
+			return nil, false, nil
+		}
+	}
+	user := it.buf[it.pos]
+	it.pos++
+	return user, true, nil
+}
+
+// Collect drains the iterator into a slice, stopping early once
+// max results have been gathered. A max of 0 collects every result.
+func (it *UsersIterator) Collect(ctx context.Context, max int) ([]*User, error) {
+	var out []*User
+	for max <= 0 || len(out) < max {
+		user, ok, err := it.Next(ctx)
+		if err != nil {
+			return out, err
+		}
+		if !ok {
+			break
+		}
+		out = append(out, user)
+	}
+	return out, nil
+}
+
+type posts struct{}
+
+// Posts implementation
+var Posts = &posts{}
+
+// Post struct
+type Post struct {
+	ID    string `json:"id,omitempty"`
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	// Comments is the nested comments relation. FindMany never populates
+	// it; it stays nil until a caller assigns it.
+	Comments []*Comment `json:"comments,omitempty"`
+}
+
+// PostsOrderBy type
+type PostsOrderBy string
+
+// OrderBy Enums
+const (
+	PostsIDASC     PostsOrderBy = "id_ASC"
+	PostsIDDESC    PostsOrderBy = "id_DESC"
+	PostsTitleASC  PostsOrderBy = "title_ASC"
+	PostsTitleDESC PostsOrderBy = "title_DESC"
+	PostsBodyASC   PostsOrderBy = "body_ASC"
+	PostsBodyDESC  PostsOrderBy = "body_DESC"
+)
+
+// PostsWhere struct
+type PostsWhere struct {
+	ID            *string        `json:"id,omitempty"`
+	IDContains    *string        `json:"id_contains,omitempty"`
+	IDIn          *[]string      `json:"id_in,omitempty"`
+	Title         *string        `json:"title,omitempty"`
+	TitleContains *string        `json:"title_contains,omitempty"`
+	TitleIn       *[]string      `json:"title_in,omitempty"`
+	Body          *string        `json:"body,omitempty"`
+	BodyContains  *string        `json:"body_contains,omitempty"`
+	BodyIn        *[]string      `json:"body_in,omitempty"`
+	CommentsSome  *CommentsWhere `json:"comments_some,omitempty"`
+	CommentsEvery *CommentsWhere `json:"comments_every,omitempty"`
+	CommentsNone  *CommentsWhere `json:"comments_none,omitempty"`
+}
+
+// PostsFindMany struct
+type PostsFindMany struct {
+	After   *string
+	Before  *string
+	First   *int
+	Last    *int
+	Skip    *int
+	OrderBy *PostsOrderBy
+	Where   *PostsWhere
+}
+
+const postsFindManyQuery = `query PostsFindMany($where: PostWhereInput, $orderBy: PostOrderByInput, $first: Int, $last: Int, $before: String, $after: String, $skip: Int) {
+  posts(where: $where, orderBy: $orderBy, first: $first, last: $last, before: $before, after: $after, skip: $skip) {
+    id
+    title
+    body
+  }
+}`
+
+func postsFindManyRequest(condition *PostsFindMany) *Request {
+	if condition == nil {
+		condition = &PostsFindMany{}
+	}
+	return &Request{
+		Query: postsFindManyQuery,
+		Variables: map[string]interface{}{
+			"where":   condition.Where,
+			"orderBy": condition.OrderBy,
+			"first":   condition.First,
+			"last":    condition.Last,
+			"before":  condition.Before,
+			"after":   condition.After,
+			"skip":    condition.Skip,
+		},
+	}
+}
+
+func (p *posts) findMany(ctx context.Context, db DB, condition *PostsFindMany) ([]*Post, error) {
+	res, err := db.Query(ctx, postsFindManyRequest(condition))
+	if err != nil {
+		return nil, fmt.Errorf("prisma: finding posts: %w", err)
+	}
+	var out struct {
+		Posts []*Post `json:"posts"`
+	}
+	if err := json.Unmarshal(res.Data, &out); err != nil {
+		return nil, fmt.Errorf("prisma: unmarshaling posts: %w", err)
+	}
+	return out.Posts, nil
+}
+
+// FindMany runs condition against db.
+func (p *posts) FindMany(ctx context.Context, db DB, condition *PostsFindMany) (posts []*Post, err error) {
+	return p.findMany(ctx, db, condition)
+}
+
+func (p *posts) FromMany(condition *PostsFindMany) *PostsFromMany {
+	return &PostsFromMany{condition, Comments}
+}
+
+// PostsFromMany struct
+type PostsFromMany struct {
+	condition *PostsFindMany
+	Comments  *comments
+}
+
+// PostsIterator pages through the results of a PostsFindMany query,
+// automatically feeding the last cursor back in as After until
+// exhausted.
+type PostsIterator struct {
+	db        DB
+	condition PostsFindMany
+	buf       []*Post
+	pos       int
+	done      bool
+}
+
+// Iterate returns a PostsIterator over condition. The condition is copied,
+// so callers may reuse the original struct.
+func (p *posts) Iterate(db DB, condition *PostsFindMany) *PostsIterator {
+	it := &PostsIterator{db: db}
+	if condition != nil {
+		it.condition = *condition
+	}
+	if it.condition.First == nil {
+		it.condition.First = Int(defaultPageSize)
+	}
+	return it
+}
+
+// Next returns the next Post, fetching another page when the
+// current one is exhausted. The bool is false once there are no
+// more results.
+func (it *PostsIterator) Next(ctx context.Context) (*Post, bool, error) {
+	if it.pos >= len(it.buf) {
+		if it.done {
+			return nil, false, nil
+		}
+		page, err := Posts.findMany(ctx, it.db, &it.condition)
+		if err != nil {
+			return nil, false, err
+		}
+		it.buf = page
+		it.pos = 0
+		if len(page) == 0 || len(page) < *it.condition.First {
+			it.done = true
+		} else {
+			it.condition.After = String(page[len(page)-1].ID)
+		}
+		if len(it.buf) == 0 {
+			return nil, false, nil
+		}
+	}
+	post := it.buf[it.pos]
+	it.pos++
+	return post, true, nil
+}
+
+// Collect drains the iterator into a slice, stopping early once
+// max results have been gathered. A max of 0 collects every result.
+func (it *PostsIterator) Collect(ctx context.Context, max int) ([]*Post, error) {
+	var out []*Post
+	for max <= 0 || len(out) < max {
+		post, ok, err := it.Next(ctx)
+		if err != nil {
+			return out, err
+		}
+		if !ok {
+			break
+		}
+		out = append(out, post)
+	}
+	return out, nil
+}
+
+type comments struct{}
+
+// Comments implementation
+var Comments = &comments{}
+
+// Comment struct
+type Comment struct {
+	ID      string `json:"id,omitempty"`
+	Comment string `json:"comment,omitempty"`
+	// Replies is the nested replies relation. FindMany never populates
+	// it; it stays nil until a caller assigns it.
+	Replies []*Comment `json:"replies,omitempty"`
+}
+
+// CommentsOrderBy type
+type CommentsOrderBy string
+
+// OrderBy Enums
+const (
+	CommentsIDASC       CommentsOrderBy = "id_ASC"
+	CommentsIDDESC      CommentsOrderBy = "id_DESC"
+	CommentsCommentASC  CommentsOrderBy = "comment_ASC"
+	CommentsCommentDESC CommentsOrderBy = "comment_DESC"
+)
+
+// CommentsWhere struct
+type CommentsWhere struct {
+	ID              *string        `json:"id,omitempty"`
+	IDContains      *string        `json:"id_contains,omitempty"`
+	IDIn            *[]string      `json:"id_in,omitempty"`
+	Comment         *string        `json:"comment,omitempty"`
+	CommentContains *string        `json:"comment_contains,omitempty"`
+	CommentIn       *[]string      `json:"comment_in,omitempty"`
+	RepliesSome     *CommentsWhere `json:"replies_some,omitempty"`
+	RepliesEvery    *CommentsWhere `json:"replies_every,omitempty"`
+	RepliesNone     *CommentsWhere `json:"replies_none,omitempty"`
+}
+
+// CommentsFindMany struct
+type CommentsFindMany struct {
+	After   *string
+	Before  *string
+	First   *int
+	Last    *int
+	Skip    *int
+	OrderBy *CommentsOrderBy
+	Where   *CommentsWhere
+}
+
+const commentsFindManyQuery = `query CommentsFindMany($where: CommentWhereInput, $orderBy: CommentOrderByInput, $first: Int, $last: Int, $before: String, $after: String, $skip: Int) {
+  comments(where: $where, orderBy: $orderBy, first: $first, last: $last, before: $before, after: $after, skip: $skip) {
+    id
+    comment
+  }
+}`
+
+func commentsFindManyRequest(condition *CommentsFindMany) *Request {
+	if condition == nil {
+		condition = &CommentsFindMany{}
+	}
+	return &Request{
+		Query: commentsFindManyQuery,
+		Variables: map[string]interface{}{
+			"where":   condition.Where,
+			"orderBy": condition.OrderBy,
+			"first":   condition.First,
+			"last":    condition.Last,
+			"before":  condition.Before,
+			"after":   condition.After,
+			"skip":    condition.Skip,
+		},
+	}
+}
+
+func (c *comments) findMany(ctx context.Context, db DB, condition *CommentsFindMany) ([]*Comment, error) {
+	res, err := db.Query(ctx, commentsFindManyRequest(condition))
+	if err != nil {
+		return nil, fmt.Errorf("prisma: finding comments: %w", err)
+	}
+	var out struct {
+		Comments []*Comment `json:"comments"`
+	}
+	if err := json.Unmarshal(res.Data, &out); err != nil {
+		return nil, fmt.Errorf("prisma: unmarshaling comments: %w", err)
+	}
+	return out.Comments, nil
+}
+
+// FindMany runs condition against db.
+func (c *comments) FindMany(ctx context.Context, db DB, condition *CommentsFindMany) (comments []*Comment, err error) {
+	return c.findMany(ctx, db, condition)
+}
+
+func (c *comments) FromMany(condition *CommentsFindMany) *CommentsFromMany {
+	return &CommentsFromMany{condition, Comments}
+}
+
+// CommentsFromMany struct
+type CommentsFromMany struct {
+	condition *CommentsFindMany
+	Replies   *comments
+}
+
+// CommentsIterator pages through the results of a CommentsFindMany query,
+// automatically feeding the last cursor back in as After until
+// exhausted.
+type CommentsIterator struct {
+	db        DB
+	condition CommentsFindMany
+	buf       []*Comment
+	pos       int
+	done      bool
+}
+
+// Iterate returns a CommentsIterator over condition. The condition is copied,
+// so callers may reuse the original struct.
+func (c *comments) Iterate(db DB, condition *CommentsFindMany) *CommentsIterator {
+	it := &CommentsIterator{db: db}
+	if condition != nil {
+		it.condition = *condition
+	}
+	if it.condition.First == nil {
+		it.condition.First = Int(defaultPageSize)
+	}
+	return it
+}
+
+// Next returns the next Comment, fetching another page when the
+// current one is exhausted. The bool is false once there are no
+// more results.
+func (it *CommentsIterator) Next(ctx context.Context) (*Comment, bool, error) {
+	if it.pos >= len(it.buf) {
+		if it.done {
+			return nil, false, nil
+		}
+		page, err := Comments.findMany(ctx, it.db, &it.condition)
+		if err != nil {
+			return nil, false, err
+		}
+		it.buf = page
+		it.pos = 0
+		if len(page) == 0 || len(page) < *it.condition.First {
+			it.done = true
+		} else {
+			it.condition.After = String(page[len(page)-1].ID)
+		}
+		if len(it.buf) == 0 {
+			return nil, false, nil
+		}
+	}
+	comment := it.buf[it.pos]
+	it.pos++
+	return comment, true, nil
+}
+
+// Collect drains the iterator into a slice, stopping early once
+// max results have been gathered. A max of 0 collects every result.
+func (it *CommentsIterator) Collect(ctx context.Context, max int) ([]*Comment, error) {
+	var out []*Comment
+	for max <= 0 || len(out) < max {
+		comment, ok, err := it.Next(ctx)
+		if err != nil {
+			return out, err
+		}
+		if !ok {
+			break
+		}
+		out = append(out, comment)
+	}
+	return out, nil
+}
+
+// LoadMore resolves a More sentinel in a follow-up round-trip,
+// returning the comments it references. The sentinel is never built by
+// this package (see More's doc comment) — it's supplied by a caller
+// who knows out-of-band which ids were left out of a relation list.
+func (c *comments) LoadMore(ctx context.Context, db DB, more *More) ([]*Comment, error) {
+	if more == nil || len(more.IDs) == 0 {
+		return nil, nil
+	}
+	ids := more.IDs
+	children, err := c.findMany(ctx, db, &CommentsFindMany{Where: &CommentsWhere{IDIn: &ids}})
+	if err != nil {
+		return nil, fmt.Errorf("prisma: loading more comments: %w", err)
+	}
+	return children, nil
+}